@@ -0,0 +1,158 @@
+// Package convert provides streaming CSV <-> XLSX conversion on top of
+// excelize, so large files convert without loading the whole sheet or CSV
+// into memory.
+package convert
+
+import (
+	"encoding/csv"
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"os"
+)
+
+// CSVOpt configures a CSV <-> XLSX conversion.
+type CSVOpt struct {
+	Delimiter rune   // field delimiter, default ','
+	HasHeader bool   // first row is a header; bolded when writing XLSX
+	Sheet     string // target/source sheet name, default "Sheet1"
+}
+
+func (o CSVOpt) delimiter() rune {
+	if o.Delimiter == 0 {
+		return ','
+	}
+	return o.Delimiter
+}
+
+func (o CSVOpt) sheet() string {
+	if o.Sheet == "" {
+		return "Sheet1"
+	}
+	return o.Sheet
+}
+
+// CSVToXLSX streams csvPath into a new workbook at xlsxPath using excelize's
+// StreamWriter, so multi-GB CSVs convert with bounded memory.
+func CSVToXLSX(csvPath, xlsxPath string, opt CSVOpt) error {
+	in, err := os.Open(csvPath)
+	if err != nil {
+		return fmt.Errorf("failed to open csv %s: %w", csvPath, err)
+	}
+	defer in.Close()
+
+	r := csv.NewReader(in)
+	r.Comma = opt.delimiter()
+	r.FieldsPerRecord = -1
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sheet := opt.sheet()
+	if sheet != "Sheet1" {
+		if _, err := f.NewSheet(sheet); err != nil {
+			return fmt.Errorf("failed to create sheet %s: %w", sheet, err)
+		}
+		if err := f.DeleteSheet("Sheet1"); err != nil {
+			return fmt.Errorf("failed to delete default sheet: %w", err)
+		}
+	}
+
+	var headerStyle int
+	if opt.HasHeader {
+		headerStyle, err = f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+		if err != nil {
+			return fmt.Errorf("failed to create header style: %w", err)
+		}
+	}
+
+	sw, err := f.NewStreamWriter(sheet)
+	if err != nil {
+		return fmt.Errorf("failed to create stream writer for %s: %w", sheet, err)
+	}
+
+	row := 1
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read csv row %d: %w", row, err)
+		}
+
+		values := make([]interface{}, len(record))
+		for i, v := range record {
+			if opt.HasHeader && row == 1 {
+				values[i] = excelize.Cell{StyleID: headerStyle, Value: v}
+			} else {
+				values[i] = v
+			}
+		}
+
+		cell, err := excelize.CoordinatesToCellName(1, row)
+		if err != nil {
+			return fmt.Errorf("failed to resolve cell for row %d: %w", row, err)
+		}
+		if err := sw.SetRow(cell, values); err != nil {
+			return fmt.Errorf("failed to write row %d: %w", row, err)
+		}
+		row++
+	}
+
+	if err := sw.Flush(); err != nil {
+		return fmt.Errorf("failed to flush stream writer: %w", err)
+	}
+	if err := f.SaveAs(xlsxPath); err != nil {
+		return fmt.Errorf("failed to save %s: %w", xlsxPath, err)
+	}
+	return nil
+}
+
+// XLSXToCSV streams sheet from xlsxPath into a CSV file at csvPath using
+// excelize's row iterator, so large workbooks convert with bounded memory.
+// If sheet is empty, opt.Sheet (or "Sheet1") is used instead.
+func XLSXToCSV(xlsxPath, csvPath, sheet string, opt CSVOpt) error {
+	f, err := excelize.OpenFile(xlsxPath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", xlsxPath, err)
+	}
+	defer f.Close()
+
+	if sheet == "" {
+		sheet = opt.sheet()
+	}
+
+	rows, err := f.Rows(sheet)
+	if err != nil {
+		return fmt.Errorf("failed to read rows from sheet %s: %w", sheet, err)
+	}
+	defer rows.Close()
+
+	out, err := os.Create(csvPath)
+	if err != nil {
+		return fmt.Errorf("failed to create csv %s: %w", csvPath, err)
+	}
+	defer out.Close()
+
+	w := csv.NewWriter(out)
+	w.Comma = opt.delimiter()
+
+	rowNum := 0
+	for rows.Next() {
+		rowNum++
+		cols, err := rows.Columns()
+		if err != nil {
+			return fmt.Errorf("failed to read row %d: %w", rowNum, err)
+		}
+		if err := w.Write(cols); err != nil {
+			return fmt.Errorf("failed to write csv row %d: %w", rowNum, err)
+		}
+	}
+	if err := rows.Error(); err != nil {
+		return fmt.Errorf("failed to read sheet %s: %w", sheet, err)
+	}
+
+	w.Flush()
+	return w.Error()
+}
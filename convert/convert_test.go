@@ -0,0 +1,96 @@
+package convert
+
+import (
+	"os"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+func TestCSVToXLSXRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := dir + "/in.csv"
+	xlsxPath := dir + "/out.xlsx"
+
+	csvContent := "ID,Name\n1,Alice\n2,Bob\n"
+	if err := os.WriteFile(csvPath, []byte(csvContent), 0o644); err != nil {
+		t.Fatalf("failed to write csv fixture: %v", err)
+	}
+
+	if err := CSVToXLSX(csvPath, xlsxPath, CSVOpt{HasHeader: true}); err != nil {
+		t.Fatalf("CSVToXLSX failed: %v", err)
+	}
+
+	f, err := excelize.OpenFile(xlsxPath)
+	if err != nil {
+		t.Fatalf("failed to open converted workbook: %v", err)
+	}
+	defer f.Close()
+
+	rows, err := f.GetRows("Sheet1")
+	if err != nil {
+		t.Fatalf("failed to read rows: %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+	if rows[1][1] != "Alice" {
+		t.Errorf("expected row 1 name Alice, got %q", rows[1][1])
+	}
+}
+
+func TestCSVToXLSXCustomSheetDropsDefault(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := dir + "/in.csv"
+	xlsxPath := dir + "/out.xlsx"
+
+	if err := os.WriteFile(csvPath, []byte("1,2\n"), 0o644); err != nil {
+		t.Fatalf("failed to write csv fixture: %v", err)
+	}
+
+	if err := CSVToXLSX(csvPath, xlsxPath, CSVOpt{Sheet: "Data"}); err != nil {
+		t.Fatalf("CSVToXLSX failed: %v", err)
+	}
+
+	f, err := excelize.OpenFile(xlsxPath)
+	if err != nil {
+		t.Fatalf("failed to open converted workbook: %v", err)
+	}
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) != 1 || sheets[0] != "Data" {
+		t.Fatalf("expected only sheet %q, got %v", "Data", sheets)
+	}
+}
+
+func TestXLSXToCSVRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	xlsxPath := dir + "/in.xlsx"
+	csvPath := dir + "/out.csv"
+
+	f := excelize.NewFile()
+	if err := f.SetSheetRow("Sheet1", "A1", &[]interface{}{"ID", "Name"}); err != nil {
+		t.Fatalf("failed to write header: %v", err)
+	}
+	if err := f.SetSheetRow("Sheet1", "A2", &[]interface{}{"1", "Alice"}); err != nil {
+		t.Fatalf("failed to write row: %v", err)
+	}
+	if err := f.SaveAs(xlsxPath); err != nil {
+		t.Fatalf("failed to save fixture: %v", err)
+	}
+	f.Close()
+
+	if err := XLSXToCSV(xlsxPath, csvPath, "", CSVOpt{}); err != nil {
+		t.Fatalf("XLSXToCSV failed: %v", err)
+	}
+
+	data, err := os.ReadFile(csvPath)
+	if err != nil {
+		t.Fatalf("failed to read converted csv: %v", err)
+	}
+	want := "ID,Name\n1,Alice\n"
+	if string(data) != want {
+		t.Errorf("expected %q, got %q", want, string(data))
+	}
+}
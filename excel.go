@@ -1,11 +1,13 @@
 package excel
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/xuri/excelize/v2"
 	"mime/multipart"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -97,6 +99,9 @@ func (e *Excel[T]) Read(out *[]T, sheetName string, opts ...Opt) error {
 	var headerRow, dataStartRow uint8 = 1, 2
 	var limit uint = 0
 	var batchSize int = 1000 // default batch size
+	var workers int = 1
+	var collectErrors bool
+	var onRowError func(RowError) ErrorAction
 	if opts != nil {
 		for _, opt := range opts {
 			if opt.HeaderRow > 0 {
@@ -111,15 +116,29 @@ func (e *Excel[T]) Read(out *[]T, sheetName string, opts ...Opt) error {
 			if opt.BatchSize > 0 {
 				batchSize = opt.BatchSize
 			}
+			if opt.Workers > 0 {
+				workers = opt.Workers
+			}
+			if opt.CollectErrors {
+				collectErrors = true
+			}
+			if opt.OnRowError != nil {
+				onRowError = opt.OnRowError
+			}
 		}
 	}
 	e.opt = Opt{
-		HeaderRow:    headerRow,
-		DataStartRow: dataStartRow,
-		Limit:        limit,
-		BatchSize:    batchSize,
+		HeaderRow:     headerRow,
+		DataStartRow:  dataStartRow,
+		Limit:         limit,
+		BatchSize:     batchSize,
+		Workers:       workers,
+		CollectErrors: collectErrors,
+		OnRowError:    onRowError,
 	}
 	e.batchSize = batchSize
+	e.workers = workers
+	e.uniqueSeen = nil // unique= tracking starts fresh with every Read
 
 	if e.file == nil {
 		return errors.New("file didn't set")
@@ -130,6 +149,7 @@ func (e *Excel[T]) Read(out *[]T, sheetName string, opts ...Opt) error {
 		return fmt.Errorf("sheet %s in file excel: %w", sheetName, errors.New("not found"))
 	}
 
+	e.sheetName = sheetName
 	e.rows, err = e.file.Rows(sheetName)
 	if err != nil {
 		return fmt.Errorf("failed to read rows: %w", err)
@@ -183,7 +203,19 @@ func (e *Excel[T]) Read(out *[]T, sheetName string, opts ...Opt) error {
 		return err
 	}
 
-	return e.rows.Error()
+	if err := e.rows.Error(); err != nil {
+		return err
+	}
+
+	if len(e.Errors) > 0 {
+		joined := make([]error, len(e.Errors))
+		for i, re := range e.Errors {
+			joined[i] = re
+		}
+		return errors.Join(joined...)
+	}
+
+	return nil
 }
 
 func (e *Excel[T]) CloseRow() error {
@@ -199,6 +231,13 @@ func (e *Excel[T]) Next(out *[]T) error {
 }
 
 func (e *Excel[T]) getRows(out *[]T) error {
+	if e.workers > 1 {
+		return e.getRowsParallel(out)
+	}
+	return e.getRowsSerial(out)
+}
+
+func (e *Excel[T]) getRowsSerial(out *[]T) error {
 	// Pre-allocate slice capacity if limit is known
 	if e.opt.Limit > 0 && cap(*out) < int(e.opt.Limit) {
 		newSlice := make([]T, len(*out), e.opt.Limit)
@@ -221,35 +260,21 @@ func (e *Excel[T]) getRows(out *[]T) error {
 		// Reset all fields to zero values for reuse
 		rv.Set(reflect.Zero(e.rt))
 
-		// Process each field rule with optimized access
-		for i := range e.rules {
-			rule := &e.rules[i] // Use pointer to avoid copying
-			var cell string
-			if rule.colIdx < len(cols) {
-				cell = strings.TrimSpace(cols[rule.colIdx])
-			}
-
-			// Early validation for required fields
-			if rule.required && cell == "" {
-				// Return instance to pool before error
+		rowErrs := e.processRow(rowJob{num: rowNum, cols: cols}, rv)
+		if len(rowErrs) > 0 {
+			action, abortErr := e.handleRowErrors(rowErrs)
+			if action == ErrorAbort {
 				e.instancePool.Put(rv)
-				return fmt.Errorf("row %d col %s (%s) is required", rowNum, idxToCol(rule.colIdx), rule.header)
-			}
-			if cell == "" {
-				continue
-			}
-
-			fv := rv.Field(rule.fieldIdx)
-			if !fv.CanSet() {
-				continue
+				return abortErr
 			}
-
-			// Use optimized field setting with cached type info
-			if err := setFieldValueOptimized(fv, cell, rule); err != nil {
-				// Return instance to pool before error
+			if action == ErrorSkip {
 				e.instancePool.Put(rv)
-				return fmt.Errorf("row %d col %s (%s): %w", rowNum, idxToCol(rule.colIdx), rule.header, err)
+				if e.opt.Limit > 0 && numberData >= e.opt.Limit {
+					break
+				}
+				continue
 			}
+			// ErrorKeepPartial: fall through and keep whatever decoded.
 		}
 
 		*out = append(*out, rv.Interface().(T))
@@ -267,6 +292,247 @@ func (e *Excel[T]) getRows(out *[]T) error {
 	return e.rows.Error()
 }
 
+// processRow decodes job's raw cells into rv (already zeroed), validating
+// each cell against its fieldRule before conversion. It is shared by
+// getRowsSerial and the parallel decode workers. With neither
+// Opt.CollectErrors nor Opt.OnRowError set it stops at the first error, the
+// same as the original inline loop; otherwise it keeps going so every field
+// error on the row is reported.
+func (e *Excel[T]) processRow(job rowJob, rv reflect.Value) []RowError {
+	collecting := e.opt.CollectErrors || e.opt.OnRowError != nil
+	var errs []RowError
+	for i := range e.rules {
+		rule := &e.rules[i]
+		var cell string
+		if rule.colIdx < len(job.cols) {
+			cell = strings.TrimSpace(job.cols[rule.colIdx])
+		}
+		if cell == "" && rule.def != "" {
+			cell = rule.def
+		}
+
+		if rule.required && cell == "" {
+			errs = append(errs, RowError{Row: job.num, Col: idxToCol(rule.colIdx), Header: rule.header, Err: errors.New("is required")})
+			if !collecting {
+				return errs
+			}
+			continue
+		}
+		if cell == "" {
+			continue
+		}
+
+		if err := e.validateCell(rule, cell); err != nil {
+			errs = append(errs, RowError{Row: job.num, Col: idxToCol(rule.colIdx), Header: rule.header, Err: err})
+			if !collecting {
+				return errs
+			}
+			continue
+		}
+
+		fv := rv.Field(rule.fieldIdx)
+		if !fv.CanSet() {
+			continue
+		}
+
+		cf := e.cellFormat(job.num, rule)
+		if err := setFieldValueOptimized(fv, cell, rule, cf); err != nil {
+			errs = append(errs, RowError{Row: job.num, Col: idxToCol(rule.colIdx), Header: rule.header, Err: err})
+			if !collecting {
+				return errs
+			}
+		}
+	}
+	return errs
+}
+
+// rowJob is one row's raw cells plus its row number, used for error messages
+// once the row reaches a decode worker.
+type rowJob struct {
+	num  uint
+	cols []string
+}
+
+// rowBatch groups up to e.batchSize consecutive rowJobs under one sequence
+// number, so a decode worker amortizes its channel handoff over many rows
+// instead of paying it per row.
+type rowBatch struct {
+	seq  uint64
+	rows []rowJob
+}
+
+// batchResult is a decoded rowBatch (or the first error hit while decoding
+// it), tagged with the sequence number of the rowBatch that produced it.
+type batchResult[T any] struct {
+	seq  uint64
+	vals []T
+	err  error
+}
+
+// getRowsParallel mirrors getRowsSerial but fans decoding out across
+// e.workers goroutines, each owning its own instancePool. Rows are grouped
+// into e.batchSize batches before dispatch and reassembled in original row
+// order via the batches' sequence numbers before appending to *out. The
+// batches channel is bounded, so a slow consumer applies backpressure to the
+// row reader instead of buffering the whole sheet in memory. The first
+// decode error cancels the shared context so the reader stops early and
+// Read returns promptly with that error's row number intact.
+func (e *Excel[T]) getRowsParallel(out *[]T) error {
+	if e.opt.Limit > 0 && cap(*out) < int(e.opt.Limit) {
+		newSlice := make([]T, len(*out), e.opt.Limit)
+		copy(newSlice, *out)
+		*out = newSlice
+	}
+
+	batchSize := e.batchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	batches := make(chan rowBatch, e.workers*2)
+	results := make(chan batchResult[T], e.workers*2)
+
+	var wg sync.WaitGroup
+	wg.Add(e.workers)
+	for w := 0; w < e.workers; w++ {
+		go func() {
+			defer wg.Done()
+			pool := &sync.Pool{
+				New: func() interface{} {
+					return reflect.New(e.rt).Elem()
+				},
+			}
+			for b := range batches {
+				results <- e.decodeBatch(b, pool)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var readErr error
+	go func() {
+		defer close(batches)
+		var numberData uint = 0
+		var seq uint64 = 0
+		cur := make([]rowJob, 0, batchSize)
+		flush := func() bool {
+			if len(cur) == 0 {
+				return true
+			}
+			seq++
+			select {
+			case batches <- rowBatch{seq: seq, rows: cur}:
+			case <-ctx.Done():
+				return false
+			}
+			cur = make([]rowJob, 0, batchSize)
+			return true
+		}
+		for e.rows.Next() {
+			numberData++
+			rowNum := numberData + uint(e.opt.DataStartRow) - 1
+			cols, err := e.rows.Columns()
+			if err != nil {
+				readErr = fmt.Errorf("read row %d: %w", rowNum, err)
+				return
+			}
+			cur = append(cur, rowJob{num: rowNum, cols: cols})
+			if len(cur) >= batchSize {
+				if !flush() {
+					return
+				}
+			}
+			if e.opt.Limit > 0 && numberData >= e.opt.Limit {
+				break
+			}
+		}
+		flush()
+	}()
+
+	pending := make(map[uint64][]T)
+	var nextSeq uint64 = 1
+	var firstErr error
+	decoded := make([]T, 0, len(*out))
+	for res := range results {
+		if res.err != nil && firstErr == nil {
+			firstErr = res.err
+			cancel()
+		}
+		pending[res.seq] = res.vals
+		for {
+			vals, ok := pending[nextSeq]
+			if !ok {
+				break
+			}
+			delete(pending, nextSeq)
+			nextSeq++
+			if firstErr == nil {
+				decoded = append(decoded, vals...)
+			}
+		}
+	}
+
+	if firstErr != nil {
+		return firstErr
+	}
+	if readErr != nil {
+		return readErr
+	}
+
+	*out = append(*out, decoded...)
+	e.IsNext = e.rows.Next()
+	return e.rows.Error()
+}
+
+// decodeBatch applies e.rules to every row in b using instances borrowed
+// from pool (the calling worker's own instancePool), stopping the whole
+// batch only on an ErrorAbort row; ErrorSkip rows are simply left out of
+// vals, and ErrorKeepPartial rows are kept as decoded so far.
+func (e *Excel[T]) decodeBatch(b rowBatch, pool *sync.Pool) batchResult[T] {
+	vals := make([]T, 0, len(b.rows))
+	for _, job := range b.rows {
+		v, skip, err := e.decodeRow(job, pool)
+		if err != nil {
+			return batchResult[T]{seq: b.seq, err: err}
+		}
+		if skip {
+			continue
+		}
+		vals = append(vals, v)
+	}
+	return batchResult[T]{seq: b.seq, vals: vals}
+}
+
+// decodeRow applies e.rules to job's raw cells using an instance borrowed
+// from pool, the same validation and conversion logic getRowsSerial uses.
+// skip reports a row dropped by Opt.OnRowError/CollectErrors; err reports a
+// row that should abort the whole Read.
+func (e *Excel[T]) decodeRow(job rowJob, pool *sync.Pool) (val T, skip bool, err error) {
+	rv := pool.Get().(reflect.Value)
+	defer pool.Put(rv)
+	rv.Set(reflect.Zero(e.rt))
+
+	rowErrs := e.processRow(job, rv)
+	if len(rowErrs) > 0 {
+		action, abortErr := e.handleRowErrors(rowErrs)
+		switch action {
+		case ErrorAbort:
+			return val, false, abortErr
+		case ErrorSkip:
+			return val, true, nil
+		}
+		// ErrorKeepPartial: fall through and keep whatever decoded.
+	}
+
+	return rv.Interface().(T), false, nil
+}
+
 func buildRules(rt reflect.Type, colIndexByHeader map[string]int) ([]fieldRule, error) {
 	// Pre-allocate rules slice with estimated capacity
 	rules := make([]fieldRule, 0, rt.NumField())
@@ -315,25 +581,64 @@ func buildRules(rt reflect.Type, colIndexByHeader map[string]int) ([]fieldRule,
 			fieldType = fieldType.Elem()
 		}
 
+		// A registered converter takes the fast path; built-in kinds keep
+		// going through setFieldValueOptimizedDirect unchanged.
+		conv := lookupConverter(fieldType)
+
+		// Cache the ExcelUnmarshaler implements-check once here so the
+		// per-row hot path in setFieldValueOptimized is a single bool read.
+		hasUnmarshaler := reflect.PointerTo(fieldType).Implements(excelUnmarshalerType)
+
+		var re *regexp.Regexp
+		if spec.regex != "" {
+			var err error
+			re, err = regexp.Compile(spec.regex)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regex %q on field %s: %w", spec.regex, sf.Name, err)
+			}
+		}
+
 		rules = append(rules, fieldRule{
-			fieldIdx:  i,
-			colIdx:    colIdx,
-			header:    spec.header,
-			required:  spec.required,
-			layout:    spec.layout,
-			fieldType: fieldType,
-			isPointer: isPointer,
-			kindCache: fieldType.Kind(),
+			fieldIdx:       i,
+			colIdx:         colIdx,
+			header:         spec.header,
+			required:       spec.required,
+			layout:         spec.layout,
+			def:            spec.def,
+			fieldType:      fieldType,
+			isPointer:      isPointer,
+			kindCache:      fieldType.Kind(),
+			fastPath:       conv != nil,
+			converter:      conv,
+			hasUnmarshaler: hasUnmarshaler,
+			min:            spec.min,
+			max:            spec.max,
+			lenEq:          spec.lenEq,
+			regex:          re,
+			oneOf:          spec.oneOf,
+			email:          spec.email,
+			unique:         spec.unique,
 		})
 	}
 	return rules, nil
 }
 
 type tagSpec struct {
-	header   string // match header text
-	fixedCol string // e.g. "A", "BC"
-	required bool
-	layout   string
+	header    string // match header text
+	fixedCol  string // e.g. "A", "BC"
+	required  bool
+	layout    string
+	def       string // value substituted when the cell is empty
+	numFmt    int    // Write: excelize built-in number format id for this column
+	styleName string // Write: name registered via Excel[T].RegisterStyle
+
+	min    *float64 // validation: numeric lower bound, inclusive
+	max    *float64 // validation: numeric upper bound, inclusive
+	lenEq  *int     // validation: exact raw cell length
+	regex  string   // validation: raw cell must match, compiled in buildRules
+	oneOf  []string // validation: raw cell must equal one of these
+	email  bool     // validation: raw cell must look like an email address
+	unique bool     // validation: raw cell must not repeat within a Read call
 }
 
 func parseTag(s string) tagSpec {
@@ -354,15 +659,142 @@ func parseTag(s string) tagSpec {
 			ts.required = true
 		case strings.HasPrefix(opt, "layout="):
 			ts.layout = strings.TrimPrefix(opt, "layout=")
+		case strings.HasPrefix(opt, "default="):
+			ts.def = strings.TrimPrefix(opt, "default=")
+		case strings.HasPrefix(opt, "numfmt="):
+			if n, err := strconv.Atoi(strings.TrimPrefix(opt, "numfmt=")); err == nil {
+				ts.numFmt = n
+			}
+		case strings.HasPrefix(opt, "style="):
+			ts.styleName = strings.TrimPrefix(opt, "style=")
 		case strings.HasPrefix(strings.ToLower(opt), "col="):
 			ts.fixedCol = strings.TrimSpace(opt[4:])
+		case opt == "email":
+			ts.email = true
+		case opt == "unique":
+			ts.unique = true
+		case strings.HasPrefix(opt, "min="):
+			if f, err := strconv.ParseFloat(strings.TrimPrefix(opt, "min="), 64); err == nil {
+				ts.min = &f
+			}
+		case strings.HasPrefix(opt, "max="):
+			if f, err := strconv.ParseFloat(strings.TrimPrefix(opt, "max="), 64); err == nil {
+				ts.max = &f
+			}
+		case strings.HasPrefix(opt, "len="):
+			if n, err := strconv.Atoi(strings.TrimPrefix(opt, "len=")); err == nil {
+				ts.lenEq = &n
+			}
+		case strings.HasPrefix(opt, "regex="):
+			ts.regex = strings.TrimPrefix(opt, "regex=")
+		case strings.HasPrefix(opt, "oneof="):
+			ts.oneOf = strings.Split(strings.TrimPrefix(opt, "oneof="), "|")
 		}
 	}
 	return ts
 }
 
+// cellFormat carries the Excel number-format hints setFieldValueOptimized
+// needs to parse a cell without a struct-tag layout: whether the cell's
+// applied format is a date, a time, or a percentage, plus (for dates/times)
+// the cell's raw serial value. rows.Columns() only ever returns the cell's
+// formatted display string (e.g. "1/15/24"), which parseExcelSerial can't
+// parse as a float, so dates/times need the underlying serial fetched
+// separately with excelize's RawCellValue option.
+type cellFormat struct {
+	isDate    bool
+	isTime    bool
+	isPercent bool
+	serial    string
+}
+
+// cellFormat resolves the number format applied to the cell at rowNum/
+// rule.colIdx, so Read can honor dates, times and percentages that arrive
+// as raw Excel serials with no `layout=` tag hint. It only bothers querying
+// excelize for kinds where the format can change how a cell is parsed, and
+// skips it entirely when a tag or custom converter already pins the format.
+func (e *Excel[T]) cellFormat(rowNum uint, rule *fieldRule) cellFormat {
+	if rule.converter != nil || rule.layout != "" {
+		return cellFormat{}
+	}
+	if rule.kindCache != reflect.Struct && rule.kindCache != reflect.Float32 && rule.kindCache != reflect.Float64 {
+		return cellFormat{}
+	}
+
+	cellRef := idxToCol(rule.colIdx) + strconv.Itoa(int(rowNum))
+	styleID, err := e.file.GetCellStyle(e.sheetName, cellRef)
+	if err != nil || styleID == 0 {
+		return cellFormat{}
+	}
+	style, err := e.file.GetStyle(styleID)
+	if err != nil || style == nil {
+		return cellFormat{}
+	}
+
+	var custom string
+	if style.CustomNumFmt != nil {
+		custom = *style.CustomNumFmt
+	}
+	isDate, isTime, isPercent := numFmtKind(style.NumFmt, custom)
+
+	var serial string
+	if isDate || isTime {
+		serial, _ = e.file.GetCellValue(e.sheetName, cellRef, excelize.Options{RawCellValue: true})
+	}
+	return cellFormat{isDate: isDate, isTime: isTime, isPercent: isPercent, serial: serial}
+}
+
+// numFmtKind classifies a built-in or custom Excel number format id/code.
+// Built-in ids follow the ECMA-376 number format table: 14-17/22/27-36 are
+// dates, 18-21/45-47 are times, 9/10 are percentages. A custom format is
+// treated as a date/time if it contains any of the Y/M/D/H/S tokens.
+func numFmtKind(id int, custom string) (isDate, isTime, isPercent bool) {
+	switch {
+	case id == 14, id == 15, id == 16, id == 17, id == 22, id >= 27 && id <= 36:
+		return true, false, false
+	case id >= 18 && id <= 21, id >= 45 && id <= 47:
+		return false, true, false
+	case id == 9, id == 10:
+		return false, false, true
+	}
+	if custom != "" && strings.ContainsAny(strings.ToUpper(custom), "YMDHS") {
+		return true, false, false
+	}
+	return false, false, false
+}
+
+// parseExcelSerial interprets raw as an Excel date serial number (days
+// since 1899-12-30), bypassing layout/text parsing entirely. Used when the
+// cell's number format already tells us it holds a date or time.
+func parseExcelSerial(raw string) (time.Time, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse excel serial date %q: %w", raw, err)
+	}
+	base := time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	sec := int64(f * 86400.0)
+	return base.Add(time.Duration(sec) * time.Second), nil
+}
+
+// parsePercent parses a percentage-formatted cell into its fractional
+// float64 value: "12%" resolves to 0.12, while a value without a trailing
+// "%" is taken as already fractional ("0.12" stays 0.12).
+func parsePercent(raw string) (float64, error) {
+	raw = strings.TrimSpace(raw)
+	hadSign := strings.HasSuffix(raw, "%")
+	raw = strings.TrimSuffix(raw, "%")
+	f, err := strconv.ParseFloat(strings.ReplaceAll(raw, ",", "."), 64)
+	if err != nil {
+		return 0, err
+	}
+	if hadSign {
+		return f / 100, nil
+	}
+	return f, nil
+}
+
 // setFieldValueOptimized uses cached type information for faster field setting
-func setFieldValueOptimized(fv reflect.Value, raw string, rule *fieldRule) (err error) {
+func setFieldValueOptimized(fv reflect.Value, raw string, rule *fieldRule, cf cellFormat) (err error) {
 	if rule == nil {
 		return fmt.Errorf("field rule cannot be nil")
 	}
@@ -378,13 +810,55 @@ func setFieldValueOptimized(fv reflect.Value, raw string, rule *fieldRule) (err
 		return fmt.Errorf("invalid reflect value")
 	}
 
+	if rule.hasUnmarshaler {
+		if rule.isPointer {
+			elem := reflect.New(rule.fieldType)
+			if err := elem.Interface().(ExcelUnmarshaler).UnmarshalExcelCell(raw, rule.layout); err != nil {
+				return fmt.Errorf("excel unmarshal: %w", err)
+			}
+			if !fv.CanSet() {
+				return fmt.Errorf("cannot set field value")
+			}
+			fv.Set(elem)
+			return nil
+		}
+		if !fv.CanAddr() {
+			return fmt.Errorf("field must be addressable to implement ExcelUnmarshaler")
+		}
+		if err := fv.Addr().Interface().(ExcelUnmarshaler).UnmarshalExcelCell(raw, rule.layout); err != nil {
+			return fmt.Errorf("excel unmarshal: %w", err)
+		}
+		return nil
+	}
+
+	if rule.converter != nil {
+		val, err := rule.converter(raw)
+		if err != nil {
+			return fmt.Errorf("custom converter: %w", err)
+		}
+		if rule.isPointer {
+			elem := reflect.New(rule.fieldType)
+			elem.Elem().Set(reflect.ValueOf(val))
+			if !fv.CanSet() {
+				return fmt.Errorf("cannot set field value")
+			}
+			fv.Set(elem)
+			return nil
+		}
+		if !fv.CanSet() {
+			return fmt.Errorf("cannot set field value")
+		}
+		fv.Set(reflect.ValueOf(val))
+		return nil
+	}
+
 	if rule.isPointer {
 		// Handle pointer types
 		if rule.fieldType == nil {
 			return fmt.Errorf("field type cannot be nil for pointer type")
 		}
 		elem := reflect.New(rule.fieldType)
-		if err := setFieldValueOptimizedDirect(elem.Elem(), raw, rule.kindCache, rule.layout); err != nil {
+		if err := setFieldValueOptimizedDirect(elem.Elem(), raw, rule.kindCache, rule.layout, cf); err != nil {
 			return fmt.Errorf("failed to set pointer field value: %w", err)
 		}
 		if !fv.CanSet() {
@@ -394,7 +868,7 @@ func setFieldValueOptimized(fv reflect.Value, raw string, rule *fieldRule) (err
 		return nil
 	}
 
-	return setFieldValueOptimizedDirect(fv, raw, rule.kindCache, rule.layout)
+	return setFieldValueOptimizedDirect(fv, raw, rule.kindCache, rule.layout, cf)
 }
 
 // setFieldValueOptimizedDirect with improved error handling
@@ -482,7 +956,7 @@ func setFieldValueOptimized(fv reflect.Value, raw string, rule *fieldRule) (err
 //}
 
 // setFieldValueOptimizedDirect sets field value directly using cached kind
-func setFieldValueOptimizedDirect(fv reflect.Value, raw string, kind reflect.Kind, layout string) error {
+func setFieldValueOptimizedDirect(fv reflect.Value, raw string, kind reflect.Kind, layout string, cf cellFormat) error {
 	switch kind {
 	case reflect.String:
 		fv.SetString(raw)
@@ -513,6 +987,14 @@ func setFieldValueOptimizedDirect(fv reflect.Value, raw string, kind reflect.Kin
 		}
 		fv.SetUint(u)
 	case reflect.Float32, reflect.Float64:
+		if cf.isPercent {
+			fl, err := parsePercent(raw)
+			if err != nil {
+				return err
+			}
+			fv.SetFloat(fl)
+			return nil
+		}
 		// Optimized float parsing
 		cleanRaw := raw
 		if strings.Contains(raw, ",") {
@@ -525,6 +1007,16 @@ func setFieldValueOptimizedDirect(fv reflect.Value, raw string, kind reflect.Kin
 		fv.SetFloat(fl)
 	case reflect.Struct:
 		if fv.Type() == reflect.TypeOf(time.Time{}) {
+			if layout == "" && (cf.isDate || cf.isTime) && cf.serial != "" {
+				// raw is the cell's formatted display string (e.g. "1/15/24"),
+				// which isn't a parseable float; cf.serial is the underlying
+				// Excel serial fetched via RawCellValue specifically so dates
+				// and times honor the cell's number format.
+				if t, err := parseExcelSerial(cf.serial); err == nil {
+					fv.Set(reflect.ValueOf(t))
+					return nil
+				}
+			}
 			t, err := parseAnyTimeOptimized(raw, layout)
 			if err != nil {
 				return err
@@ -0,0 +1,78 @@
+package excel
+
+import (
+	"github.com/xuri/excelize/v2"
+	"testing"
+)
+
+type benchRow struct {
+	ID    int     `excel:"ID"`
+	Name  string  `excel:"Name"`
+	Email string  `excel:"Email"`
+	Score float64 `excel:"Score"`
+}
+
+func benchWorkbook(b *testing.B, rows int) string {
+	b.Helper()
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetRow("Sheet1", "A1", &[]interface{}{"ID", "Name", "Email", "Score"}); err != nil {
+		b.Fatalf("failed to write header: %v", err)
+	}
+	for i := 1; i <= rows; i++ {
+		cell := "A" + itoaBench(i+1)
+		row := []interface{}{i, "user", "user@example.com", float64(i) * 1.5}
+		if err := f.SetSheetRow("Sheet1", cell, &row); err != nil {
+			b.Fatalf("failed to write row %d: %v", i, err)
+		}
+	}
+
+	path := b.TempDir() + "/bench.xlsx"
+	if err := f.SaveAs(path); err != nil {
+		b.Fatalf("failed to save workbook: %v", err)
+	}
+	return path
+}
+
+func itoaBench(n int) string {
+	if n == 0 {
+		return "0"
+	}
+	var digits []byte
+	for n > 0 {
+		digits = append([]byte{byte('0' + n%10)}, digits...)
+		n /= 10
+	}
+	return string(digits)
+}
+
+func benchmarkRead(b *testing.B, workers int) {
+	path := benchWorkbook(b, 5000)
+
+	for i := 0; i < b.N; i++ {
+		e, err := Open[benchRow](path)
+		if err != nil {
+			b.Fatalf("failed to open workbook: %v", err)
+		}
+		var out []benchRow
+		err = e.Read(&out, "Sheet1", Opt{Workers: workers})
+		if err != nil {
+			b.Fatalf("failed to read: %v", err)
+		}
+		e.Close()
+	}
+}
+
+func BenchmarkReadSerial(b *testing.B) {
+	benchmarkRead(b, 1)
+}
+
+func BenchmarkReadParallel4(b *testing.B) {
+	benchmarkRead(b, 4)
+}
+
+func BenchmarkReadParallel8(b *testing.B) {
+	benchmarkRead(b, 8)
+}
@@ -0,0 +1,245 @@
+package excel
+
+import (
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"reflect"
+	"strings"
+)
+
+// exportField describes one struct field surfaced through the Exporter
+// chain: which field to read and what header text to show for it.
+type exportField struct {
+	name     string // struct field name, used to resolve Columns() arguments
+	header   string
+	fieldIdx int
+}
+
+// exportFields derives the default column set for rt: every exported field,
+// in declaration order, labelled from its `excel` tag, falling back to its
+// `json` tag and then the field name itself.
+func exportFields(rt reflect.Type) []exportField {
+	fields := make([]exportField, 0, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if sf.PkgPath != "" {
+			continue // unexported
+		}
+		header := sf.Name
+		if tag := sf.Tag.Get("excel"); tag != "" && tag != "-" {
+			if spec := parseTag(tag); spec.header != "" {
+				header = spec.header
+			}
+		} else if tag := sf.Tag.Get("json"); tag != "" && tag != "-" {
+			if name := strings.Split(tag, ",")[0]; name != "" {
+				header = name
+			}
+		}
+		fields = append(fields, exportField{name: sf.Name, header: header, fieldIdx: i})
+	}
+	return fields
+}
+
+// Exporter is a chainable builder that marshals a struct slice into an XLSX
+// workbook. Every method returns the same *Exporter so calls chain; errors
+// are recorded on Error and short-circuit the rest of the chain, so callers
+// only need to check .Error (or the SaveAs return value) once at the end.
+type Exporter struct {
+	file       *File
+	sheet      string
+	data       reflect.Value
+	rt         reflect.Type
+	fields     []exportField
+	styles     map[int]int // 1-based column index -> style ID
+	autoFilter bool
+	freezeRows int
+	Error      error
+}
+
+// Export starts a chainable export of slice, which must be a slice of
+// structs or struct pointers.
+func Export(slice interface{}) *Exporter {
+	ex := &Exporter{
+		file:   NewExcelFile(),
+		sheet:  "Sheet1",
+		styles: map[int]int{},
+	}
+
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		ex.Error = fmt.Errorf("excel: Export expects a slice, got %s", rv.Kind())
+		return ex
+	}
+
+	et := rv.Type().Elem()
+	if et.Kind() == reflect.Pointer {
+		et = et.Elem()
+	}
+	if et.Kind() != reflect.Struct {
+		ex.Error = fmt.Errorf("excel: Export expects a slice of structs, got slice of %s", et.Kind())
+		return ex
+	}
+
+	ex.data = rv
+	ex.rt = et
+	return ex
+}
+
+// Sheet sets the destination sheet name. Defaults to "Sheet1".
+func (ex *Exporter) Sheet(name string) *Exporter {
+	if ex.Error != nil || name == "" || name == ex.sheet {
+		return ex
+	}
+	if _, err := ex.file.file.NewSheet(name); err != nil {
+		ex.Error = fmt.Errorf("failed to create sheet %s: %w", name, err)
+		return ex
+	}
+	if ex.sheet == "Sheet1" {
+		_ = ex.file.DeleteSheet("Sheet1")
+	}
+	ex.sheet = name
+	return ex
+}
+
+// Columns selects and orders which fields are exported, matched against
+// each field's struct name or resolved header (case-insensitive).
+func (ex *Exporter) Columns(cols ...string) *Exporter {
+	if ex.Error != nil {
+		return ex
+	}
+	all := exportFields(ex.rt)
+	selected := make([]exportField, 0, len(cols))
+	for _, c := range cols {
+		found := false
+		for _, f := range all {
+			if strings.EqualFold(f.name, c) || strings.EqualFold(f.header, c) {
+				selected = append(selected, f)
+				found = true
+				break
+			}
+		}
+		if !found {
+			ex.Error = fmt.Errorf("excel: Columns: field %q not found on %s", c, ex.rt.Name())
+			return ex
+		}
+	}
+	ex.fields = selected
+	return ex
+}
+
+// Headers overrides the header text for the current column set (all fields,
+// or the subset chosen by Columns), positionally.
+func (ex *Exporter) Headers(labels ...string) *Exporter {
+	if ex.Error != nil {
+		return ex
+	}
+	if ex.fields == nil {
+		ex.fields = exportFields(ex.rt)
+	}
+	if len(labels) != len(ex.fields) {
+		ex.Error = fmt.Errorf("excel: Headers: expected %d labels, got %d", len(ex.fields), len(labels))
+		return ex
+	}
+	for i := range ex.fields {
+		ex.fields[i].header = labels[i]
+	}
+	return ex
+}
+
+// Style attaches styleID (from File.CreateStyle) to every data cell in the
+// 1-based output column colIdx.
+func (ex *Exporter) Style(colIdx, styleID int) *Exporter {
+	if ex.Error != nil {
+		return ex
+	}
+	ex.styles[colIdx] = styleID
+	return ex
+}
+
+// AutoFilter enables an auto filter over the full header and data range.
+func (ex *Exporter) AutoFilter() *Exporter {
+	if ex.Error == nil {
+		ex.autoFilter = true
+	}
+	return ex
+}
+
+// Freeze freezes the top n rows (typically 1, to keep the header visible).
+func (ex *Exporter) Freeze(rows int) *Exporter {
+	if ex.Error == nil {
+		ex.freezeRows = rows
+	}
+	return ex
+}
+
+// SaveAs renders the chain into an XLSX workbook and saves it to path. It
+// returns the same error recorded on Error, so callers may check either.
+func (ex *Exporter) SaveAs(path string) error {
+	if ex.Error != nil {
+		return ex.Error
+	}
+	if ex.fields == nil {
+		ex.fields = exportFields(ex.rt)
+	}
+
+	headers := make([]string, len(ex.fields))
+	for i, f := range ex.fields {
+		headers[i] = f.header
+	}
+	if err := ex.file.SetHeaders(ex.sheet, headers); err != nil {
+		ex.Error = err
+		return ex.Error
+	}
+
+	n := ex.data.Len()
+	rows := make([][]interface{}, n)
+	for r := 0; r < n; r++ {
+		item := ex.data.Index(r)
+		if item.Kind() == reflect.Pointer {
+			item = item.Elem()
+		}
+		row := make([]interface{}, len(ex.fields))
+		for i, f := range ex.fields {
+			row[i] = item.Field(f.fieldIdx).Interface()
+		}
+		rows[r] = row
+	}
+	if err := ex.file.WriteData(ex.sheet, 2, rows); err != nil {
+		ex.Error = err
+		return ex.Error
+	}
+
+	for colIdx, styleID := range ex.styles {
+		for r := 0; r < n; r++ {
+			if err := ex.file.SetCellStyle(ex.sheet, r+2, colIdx, styleID); err != nil {
+				ex.Error = err
+				return ex.Error
+			}
+		}
+	}
+
+	if ex.autoFilter && len(ex.fields) > 0 {
+		if err := ex.file.AutoFilter(ex.sheet, 1, 1, n+1, len(ex.fields)); err != nil {
+			ex.Error = err
+			return ex.Error
+		}
+	}
+
+	if ex.freezeRows > 0 {
+		if err := ex.file.file.SetPanes(ex.sheet, &excelize.Panes{
+			Freeze:      true,
+			YSplit:      ex.freezeRows,
+			TopLeftCell: fmt.Sprintf("A%d", ex.freezeRows+1),
+			ActivePane:  "bottomLeft",
+		}); err != nil {
+			ex.Error = fmt.Errorf("failed to freeze panes: %w", err)
+			return ex.Error
+		}
+	}
+
+	if err := ex.file.SaveAs(path); err != nil {
+		ex.Error = err
+		return ex.Error
+	}
+	return nil
+}
@@ -251,6 +251,44 @@ func (f *File) SetColumnWidth(sheet string, col int, width float64) error {
 	return nil
 }
 
+// SetRangeStyle applies styleID to every cell in the rectangular range from
+// topLeft to bottomRight (e.g. "A1" to "D1").
+func (f *File) SetRangeStyle(sheet, topLeft, bottomRight string, styleID int) error {
+	err := f.file.SetCellStyle(sheet, topLeft, bottomRight, styleID)
+	if err != nil {
+		return fmt.Errorf("failed to set style on range %s:%s: %w", topLeft, bottomRight, err)
+	}
+	return nil
+}
+
+// MergeCells merges every cell in the rectangular range from topLeft to
+// bottomRight into one.
+func (f *File) MergeCells(sheet, topLeft, bottomRight string) error {
+	err := f.file.MergeCell(sheet, topLeft, bottomRight)
+	if err != nil {
+		return fmt.Errorf("failed to merge %s:%s: %w", topLeft, bottomRight, err)
+	}
+	return nil
+}
+
+// SetCellFormula sets the formula for a single cell (e.g. "=SUM(A2:C2)").
+func (f *File) SetCellFormula(sheet, cell, formula string) error {
+	err := f.file.SetCellFormula(sheet, cell, formula)
+	if err != nil {
+		return fmt.Errorf("failed to set formula at %s: %w", cell, err)
+	}
+	return nil
+}
+
+// InsertRows inserts n blank rows before row, shifting existing rows down.
+func (f *File) InsertRows(sheet string, row, n int) error {
+	err := f.file.InsertRows(sheet, row, n)
+	if err != nil {
+		return fmt.Errorf("failed to insert %d rows at %d: %w", n, row, err)
+	}
+	return nil
+}
+
 // SaveAs saves the Excel file with a specific filename
 func (f *File) SaveAs(filename string) error {
 	err := f.file.SaveAs(filename)
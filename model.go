@@ -3,6 +3,7 @@ package excel
 import (
 	"github.com/xuri/excelize/v2"
 	"reflect"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -25,76 +26,94 @@ var (
 			return make([]byte, 0, 64)
 		},
 	}
-
-	fieldCache = sync.Map{}
 )
 
 type (
 	Excel[T any] struct {
-		file     *excelize.File
-		IsNext   bool
-		rt       reflect.Type
-		rows     *excelize.Rows
-		rules    []fieldRule
-		opt      Opt
-		workers  int
-		fieldMap map[string]int   // Cache for field lookups
-		typeInfo *typeInformation // Cache for type information
+		file         *excelize.File
+		IsNext       bool
+		rt           reflect.Type
+		rows         *excelize.Rows
+		rules        []fieldRule
+		opt          Opt
+		sheetName    string
+		batchSize    int
+		workers      int
+		instancePool *sync.Pool
+		streamWriter *excelize.StreamWriter
+		styles       map[string]excelize.Style // named styles registered via RegisterStyle
+		Errors       []RowError                // validation/conversion errors collected when Opt.CollectErrors or Opt.OnRowError is set
+		errMu        sync.Mutex                // guards Errors across parallel decode workers
+		uniqueMu     sync.Mutex                // guards uniqueSeen across parallel decode workers
+		uniqueSeen   map[int]map[string]struct{}
 	}
 
 	Opt struct {
-		HeaderRow    uint8
-		DataStartRow uint8
-		Limit        uint
-		Workers      int  // Number of worker goroutines
-		UseCache     bool // Enable field caching
+		HeaderRow     uint8
+		DataStartRow  uint8
+		Limit         uint
+		BatchSize     int  // Rows pre-allocated per read batch
+		Workers       int  // Number of worker goroutines
+		UseCache      bool // Not read by any code in this package
+		CollectErrors bool // Skip rows with validation/conversion errors instead of aborting Read, collecting them into Excel[T].Errors
+		OnRowError    func(RowError) ErrorAction
 	}
 
 	fieldRule struct {
-		fieldIdx  int
-		colIdx    int
-		header    string
-		required  bool
-		layout    string
-		fastPath  bool      // Indicates if fast path processing is available
-		converter converter // Fast path conversion function
-	}
-
-	typeInformation struct {
-		fields     []reflect.StructField
-		converters []converter
-		fieldMap   map[string]int
+		fieldIdx       int
+		colIdx         int
+		header         string
+		required       bool
+		layout         string
+		def            string         // value substituted when the cell is empty
+		fieldType      reflect.Type   // element type (pointer already unwrapped)
+		isPointer      bool           // true if the struct field itself is a pointer
+		kindCache      reflect.Kind   // cached fieldType.Kind()
+		fastPath       bool           // true when a registered converter applies to fieldType
+		converter      converter      // user-registered conversion function, if any
+		hasUnmarshaler bool           // true if *fieldType implements ExcelUnmarshaler
+		min            *float64       // validation: numeric lower bound, inclusive
+		max            *float64       // validation: numeric upper bound, inclusive
+		lenEq          *int           // validation: exact raw cell length
+		regex          *regexp.Regexp // validation: raw cell must match
+		oneOf          []string       // validation: raw cell must equal one of these
+		email          bool           // validation: raw cell must look like an email address
+		unique         bool           // validation: raw cell must not repeat within this Read call
 	}
 
 	converter func(string) (interface{}, error)
 )
 
-// Cache type information
-func cacheTypeInfo(t reflect.Type) *typeInformation {
-	info := &typeInformation{
-		fieldMap: make(map[string]int),
-	}
+// ExcelUnmarshaler lets a field type own its cell decoding, the same way
+// encoding.TextUnmarshaler does for text. UnmarshalExcelCell receives the
+// cell's trimmed raw value and the field's tag `layout=` (empty if none was
+// set). Implement it on a pointer receiver; setFieldValueOptimized checks
+// for it ahead of RegisterConverter and the built-in kind switch.
+type ExcelUnmarshaler interface {
+	UnmarshalExcelCell(raw string, layout string) error
+}
 
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		info.fields = append(info.fields, field)
-		info.fieldMap[field.Name] = i
+var excelUnmarshalerType = reflect.TypeOf((*ExcelUnmarshaler)(nil)).Elem()
 
-		// Create optimized converter for the field
-		conv := getOptimizedConverter(field.Type)
-		info.converters = append(info.converters, conv)
-	}
+var (
+	converterRegistryMu sync.RWMutex
+	converterRegistry   = map[reflect.Type]converter{}
+)
 
-	return info
+// RegisterConverter installs a custom cell converter for t, so any struct
+// field of type t (or *t) tagged `excel:"..."` is decoded through conv
+// instead of the built-in kind-based conversion. Use this for domain types
+// the reflect-kind switch doesn't know about, e.g. decimal.Decimal or
+// uuid.UUID.
+func RegisterConverter(t reflect.Type, conv converter) {
+	converterRegistryMu.Lock()
+	defer converterRegistryMu.Unlock()
+	converterRegistry[t] = conv
 }
 
-// Get cached type information
-func getTypeInfo(t reflect.Type) *typeInformation {
-	if cached, ok := fieldCache.Load(t); ok {
-		return cached.(*typeInformation)
-	}
-
-	info := cacheTypeInfo(t)
-	fieldCache.Store(t, info)
-	return info
+func lookupConverter(t reflect.Type) converter {
+	converterRegistryMu.RLock()
+	defer converterRegistryMu.RUnlock()
+	return converterRegistry[t]
 }
+
@@ -0,0 +1,74 @@
+package excel
+
+import (
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+type multiCustomer struct {
+	ID   int    `excel:"ID"`
+	Name string `excel:"Name"`
+}
+
+type multiOrder struct {
+	ID       int     `excel:"ID"`
+	Customer int     `excel:"Customer"`
+	Total    float64 `excel:"Total"`
+}
+
+func multiWorkbook(t *testing.T) string {
+	t.Helper()
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetRow("Sheet1", "A1", &[]interface{}{"ID", "Name"}); err != nil {
+		t.Fatalf("failed to write Customers header: %v", err)
+	}
+	if err := f.SetSheetRow("Sheet1", "A2", &[]interface{}{1, "Alice"}); err != nil {
+		t.Fatalf("failed to write Customers row: %v", err)
+	}
+
+	if _, err := f.NewSheet("Orders"); err != nil {
+		t.Fatalf("failed to create Orders sheet: %v", err)
+	}
+	if err := f.SetSheetRow("Orders", "A1", &[]interface{}{"ID", "Customer", "Total"}); err != nil {
+		t.Fatalf("failed to write Orders header: %v", err)
+	}
+	if err := f.SetSheetRow("Orders", "A2", &[]interface{}{1, 1, 19.99}); err != nil {
+		t.Fatalf("failed to write Orders row: %v", err)
+	}
+
+	path := t.TempDir() + "/multi.xlsx"
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("failed to save workbook: %v", err)
+	}
+	return path
+}
+
+func TestMultiExcelReadAll(t *testing.T) {
+	path := multiWorkbook(t)
+
+	m, err := OpenMulti(path)
+	if err != nil {
+		t.Fatalf("failed to open workbook: %v", err)
+	}
+	defer m.Close()
+
+	var customers []multiCustomer
+	var orders []multiOrder
+	Bind(m, "Sheet1", &customers)
+	Bind(m, "Orders", &orders)
+
+	if err := m.ReadAll(); err != nil {
+		t.Fatalf("ReadAll failed: %v", err)
+	}
+
+	if len(customers) != 1 || customers[0].Name != "Alice" {
+		t.Fatalf("unexpected customers: %+v", customers)
+	}
+	if len(orders) != 1 || orders[0].Total != 19.99 {
+		t.Fatalf("unexpected orders: %+v", orders)
+	}
+}
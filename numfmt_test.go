@@ -0,0 +1,63 @@
+package excel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+type numFmtRow struct {
+	ID   int       `excel:"ID"`
+	When time.Time `excel:"When"`
+}
+
+func TestReadHonorsDateNumberFormat(t *testing.T) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetRow("Sheet1", "A1", &[]interface{}{"ID", "When"}); err != nil {
+		t.Fatalf("failed to write header: %v", err)
+	}
+	if err := f.SetCellValue("Sheet1", "A2", 1); err != nil {
+		t.Fatalf("failed to write ID: %v", err)
+	}
+	// Store a genuine date cell: excelize writes time.Time as a numeric
+	// serial, and the style below is what tells a real spreadsheet app (and
+	// Read) to render/parse it as a date rather than a bare number. Without
+	// RawCellValue, rows.Columns() would hand back the formatted display
+	// string (e.g. "1/15/24"), not this serial.
+	if err := f.SetCellValue("Sheet1", "B2", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("failed to write date: %v", err)
+	}
+	styleID, err := f.NewStyle(&excelize.Style{NumFmt: 14})
+	if err != nil {
+		t.Fatalf("failed to create date style: %v", err)
+	}
+	if err := f.SetCellStyle("Sheet1", "B2", "B2", styleID); err != nil {
+		t.Fatalf("failed to apply date style: %v", err)
+	}
+
+	path := t.TempDir() + "/numfmt.xlsx"
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("failed to save workbook: %v", err)
+	}
+
+	e, err := Open[numFmtRow](path)
+	if err != nil {
+		t.Fatalf("failed to open workbook: %v", err)
+	}
+	defer e.Close()
+
+	var out []numFmtRow
+	if err := e.Read(&out, "Sheet1"); err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if len(out) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(out))
+	}
+	want := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	if !out[0].When.Equal(want) {
+		t.Errorf("expected %s, got %s", want, out[0].When)
+	}
+}
@@ -0,0 +1,177 @@
+package excel
+
+import (
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"reflect"
+	"sort"
+)
+
+// WriteStreamOpt configures a WriteStream call.
+type WriteStreamOpt struct {
+	HeaderRow uint8          // row the header line is written to, default 1
+	Styles    map[string]int // header -> style ID applied to that column's data cells
+}
+
+// writeRule mirrors fieldRule but for the write direction: it only needs to
+// know which struct field feeds which output column, plus the formatting
+// the `numfmt=`/`style=` tag options ask for.
+type writeRule struct {
+	fieldIdx  int
+	header    string
+	colIdx    int
+	numFmt    int
+	styleName string
+}
+
+// buildWriteRules derives column order, headers and formatting from the
+// `excel` struct tag, the same grammar buildRules uses for reading. Fields
+// are ordered by their position among the tagged fields; a `col=` tag gives
+// a field that letter's index as its position instead. Write and WriteStream
+// place the sorted fields in consecutive columns starting at A, so a `col=`
+// letter sets the field's order, not the column it is written to.
+func buildWriteRules(rt reflect.Type) ([]writeRule, error) {
+	rules := make([]writeRule, 0, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		tag := sf.Tag.Get("excel")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		spec := parseTag(tag)
+		header := spec.header
+		if header == "" {
+			header = sf.Name
+		}
+		colIdx := len(rules)
+		if spec.fixedCol != "" {
+			idx, ok := colToIdx(spec.fixedCol)
+			if !ok {
+				return nil, fmt.Errorf("invalid column letter %q on field %s", spec.fixedCol, sf.Name)
+			}
+			colIdx = idx
+		}
+		rules = append(rules, writeRule{
+			fieldIdx:  i,
+			header:    header,
+			colIdx:    colIdx,
+			numFmt:    spec.numFmt,
+			styleName: spec.styleName,
+		})
+	}
+	sort.SliceStable(rules, func(i, j int) bool { return rules[i].colIdx < rules[j].colIdx })
+	return rules, nil
+}
+
+// WriteStream consumes values from in and writes them to sheetName using
+// excelize's StreamWriter, so memory stays bounded no matter how many rows
+// flow through. Column order and headers come from the same `excel:"..."`
+// struct tags Read uses. The channel is drained to completion and the
+// StreamWriter is flushed before WriteStream returns; call Flush yourself
+// only if you write rows by hand outside of this method.
+func (e *Excel[T]) WriteStream(sheetName string, in <-chan T, opts ...WriteStreamOpt) error {
+	var headerRow uint8 = 1
+	var styles map[string]int
+	for _, opt := range opts {
+		if opt.HeaderRow > 0 {
+			headerRow = opt.HeaderRow
+		}
+		if opt.Styles != nil {
+			styles = opt.Styles
+		}
+	}
+
+	if e.file == nil {
+		return fmt.Errorf("file didn't set")
+	}
+
+	if e.rt == nil {
+		var zero T
+		rt := reflect.TypeOf(zero)
+		if rt.Kind() == reflect.Pointer {
+			rt = rt.Elem()
+		}
+		e.rt = rt
+	}
+
+	rules, err := buildWriteRules(e.rt)
+	if err != nil {
+		return err
+	}
+
+	if _, err := e.file.GetSheetIndex(sheetName); err != nil {
+		if _, err := e.file.NewSheet(sheetName); err != nil {
+			return fmt.Errorf("failed to create sheet %s: %w", sheetName, err)
+		}
+	}
+
+	sw, err := e.file.NewStreamWriter(sheetName)
+	if err != nil {
+		return fmt.Errorf("failed to create stream writer for %s: %w", sheetName, err)
+	}
+	e.streamWriter = sw
+
+	headerCell, err := excelize.CoordinatesToCellName(1, int(headerRow))
+	if err != nil {
+		return fmt.Errorf("failed to resolve header cell: %w", err)
+	}
+	headerCells := make([]interface{}, len(rules))
+	for i, r := range rules {
+		headerCells[i] = r.header
+	}
+	if err := sw.SetRow(headerCell, headerCells); err != nil {
+		return fmt.Errorf("failed to write header row: %w", err)
+	}
+
+	colStyle := make([]int, len(rules))
+	for i, r := range rules {
+		id, err := e.resolveStyle(r)
+		if err != nil {
+			return err
+		}
+		if styles != nil && styles[r.header] != 0 {
+			id = styles[r.header]
+		}
+		colStyle[i] = id
+	}
+
+	row := int(headerRow) + 1
+	buf := make([]interface{}, len(rules))
+	for v := range in {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.Pointer {
+			rv = rv.Elem()
+		}
+		for i, r := range rules {
+			fv := rv.Field(r.fieldIdx)
+			if colStyle[i] != 0 {
+				buf[i] = excelize.Cell{StyleID: colStyle[i], Value: fv.Interface()}
+			} else {
+				buf[i] = fv.Interface()
+			}
+		}
+		cell, err := excelize.CoordinatesToCellName(1, row)
+		if err != nil {
+			return fmt.Errorf("failed to resolve cell for row %d: %w", row, err)
+		}
+		if err := sw.SetRow(cell, buf); err != nil {
+			return fmt.Errorf("failed to write row %d: %w", row, err)
+		}
+		row++
+	}
+
+	return e.Flush()
+}
+
+// Flush commits any rows buffered by the StreamWriter started in WriteStream
+// to the underlying file. WriteStream calls it automatically once its input
+// channel is drained.
+func (e *Excel[T]) Flush() error {
+	if e.streamWriter == nil {
+		return nil
+	}
+	if err := e.streamWriter.Flush(); err != nil {
+		return fmt.Errorf("failed to flush stream writer: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package excel
+
+import (
+	"testing"
+)
+
+type streamRow struct {
+	ID    int    `excel:"ID"`
+	Name  string `excel:"Name"`
+	Email string `excel:"Email"`
+}
+
+func TestWriteStreamRoundTrip(t *testing.T) {
+	const rows = 20
+
+	e := New[streamRow]()
+	defer e.Close()
+
+	in := make(chan streamRow)
+	go func() {
+		defer close(in)
+		for i := 1; i <= rows; i++ {
+			in <- streamRow{ID: i, Name: "user", Email: "user@example.com"}
+		}
+	}()
+
+	if err := e.WriteStream("Sheet1", in); err != nil {
+		t.Fatalf("WriteStream failed: %v", err)
+	}
+
+	path := t.TempDir() + "/stream.xlsx"
+	if err := e.file.SaveAs(path); err != nil {
+		t.Fatalf("failed to save workbook: %v", err)
+	}
+
+	r, err := Open[streamRow](path)
+	if err != nil {
+		t.Fatalf("failed to reopen workbook: %v", err)
+	}
+	defer r.Close()
+
+	var out []streamRow
+	if err := r.Read(&out, "Sheet1"); err != nil {
+		t.Fatalf("failed to read back rows: %v", err)
+	}
+	if len(out) != rows {
+		t.Fatalf("expected %d rows, got %d", rows, len(out))
+	}
+	if out[rows-1].ID != rows {
+		t.Errorf("expected last row ID %d, got %d", rows, out[rows-1].ID)
+	}
+}
@@ -0,0 +1,352 @@
+// Package template renders XLSX reports from a YAML action list applied to
+// a cloned template workbook, so report layouts (invoices, statements) can
+// be described in data instead of hand-coded cell coordinates.
+package template
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/tss182/excel"
+	"github.com/xuri/excelize/v2"
+	"gopkg.in/yaml.v3"
+	"os"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+// ActionType names one of the operations a Descriptor can perform.
+type ActionType string
+
+const (
+	ActionSetCell     ActionType = "set-cell"
+	ActionInsertRows  ActionType = "insert-rows-from-query"
+	ActionRepeatBlock ActionType = "repeat-block"
+	ActionApplyStyle  ActionType = "apply-style"
+	ActionMerge       ActionType = "merge"
+	ActionFormula     ActionType = "formula"
+)
+
+// Action is a single descriptor step. Not every field applies to every
+// ActionType; the comments on the action-specific fields name the action
+// types that read them.
+type Action struct {
+	Type  ActionType `yaml:"type"`
+	Sheet string     `yaml:"sheet"`
+
+	Cell  string `yaml:"cell"`  // set-cell, formula
+	Value string `yaml:"value"` // set-cell: Go text/template expression evaluated against ctx
+
+	Start string `yaml:"start"` // insert-rows-from-query: top-left cell for the first result row
+	Query string `yaml:"query"` // insert-rows-from-query: SQL run against ctx's *sql.DB
+
+	StartRow int    `yaml:"start_row"` // repeat-block
+	EndRow   int    `yaml:"end_row"`   // repeat-block
+	Items    string `yaml:"items"`     // repeat-block: dotted path into ctx resolving to a slice
+
+	Range string `yaml:"range"` // apply-style, merge: "TOPLEFT:BOTTOMRIGHT"
+	Style string `yaml:"style"` // apply-style: key into Descriptor.Styles
+
+	Formula string `yaml:"formula"` // formula
+}
+
+// StyleDef mirrors excel.Style for YAML decoding.
+type StyleDef struct {
+	Font      *excel.Font      `yaml:"font"`
+	Fill      excel.Fill       `yaml:"fill"`
+	Alignment *excel.Alignment `yaml:"alignment"`
+	NumFmt    int              `yaml:"numfmt"`
+}
+
+func (s StyleDef) toStyle() *excel.Style {
+	return &excel.Style{
+		Font:      s.Font,
+		Fill:      s.Fill,
+		Alignment: s.Alignment,
+		NumFmt:    s.NumFmt,
+	}
+}
+
+// Descriptor is the parsed form of a report's YAML definition.
+type Descriptor struct {
+	Actions []Action            `yaml:"actions"`
+	Styles  map[string]StyleDef `yaml:"styles"`
+}
+
+// ParseDescriptor reads and parses a YAML descriptor file.
+func ParseDescriptor(path string) (*Descriptor, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read descriptor %s: %w", path, err)
+	}
+	var desc Descriptor
+	if err := yaml.Unmarshal(raw, &desc); err != nil {
+		return nil, fmt.Errorf("failed to parse descriptor %s: %w", path, err)
+	}
+	return &desc, nil
+}
+
+// Render clones templatePath, runs descriptorPath's actions against ctx (a
+// struct, map, or *sql.DB for query-backed actions) and returns the filled
+// workbook. The caller is responsible for saving or closing the result.
+func Render(templatePath, descriptorPath string, ctx interface{}) (*excel.File, error) {
+	desc, err := ParseDescriptor(descriptorPath)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := excel.OpenExcelFile(templatePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open template %s: %w", templatePath, err)
+	}
+
+	r := &renderer{file: f, ctx: ctx, styles: make(map[string]int, len(desc.Styles))}
+	for name, def := range desc.Styles {
+		id, err := f.CreateStyle(def.toStyle())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create style %q: %w", name, err)
+		}
+		r.styles[name] = id
+	}
+
+	for i, action := range desc.Actions {
+		if err := r.apply(action); err != nil {
+			return nil, fmt.Errorf("action %d (%s): %w", i, action.Type, err)
+		}
+	}
+
+	return f, nil
+}
+
+type renderer struct {
+	file   *excel.File
+	ctx    interface{}
+	styles map[string]int
+}
+
+func (r *renderer) apply(a Action) error {
+	switch a.Type {
+	case ActionSetCell:
+		return r.setCell(a)
+	case ActionInsertRows:
+		return r.insertRowsFromQuery(a)
+	case ActionRepeatBlock:
+		return r.repeatBlock(a)
+	case ActionApplyStyle:
+		return r.applyStyle(a)
+	case ActionMerge:
+		return r.merge(a)
+	case ActionFormula:
+		return r.formula(a)
+	default:
+		return fmt.Errorf("unknown action type %q", a.Type)
+	}
+}
+
+func (r *renderer) setCell(a Action) error {
+	val, err := evalString(r.ctx, a.Value)
+	if err != nil {
+		return err
+	}
+	col, row, err := excelize.CellNameToCoordinates(a.Cell)
+	if err != nil {
+		return fmt.Errorf("invalid cell %q: %w", a.Cell, err)
+	}
+	return r.file.SetCellValue(a.Sheet, row, col, val)
+}
+
+func (r *renderer) formula(a Action) error {
+	return r.file.SetCellFormula(a.Sheet, a.Cell, a.Formula)
+}
+
+func (r *renderer) merge(a Action) error {
+	topLeft, bottomRight, err := splitRange(a.Range)
+	if err != nil {
+		return err
+	}
+	return r.file.MergeCells(a.Sheet, topLeft, bottomRight)
+}
+
+func (r *renderer) applyStyle(a Action) error {
+	styleID, ok := r.styles[a.Style]
+	if !ok {
+		return fmt.Errorf("unknown style %q", a.Style)
+	}
+	topLeft, bottomRight, err := splitRange(a.Range)
+	if err != nil {
+		return err
+	}
+	return r.file.SetRangeStyle(a.Sheet, topLeft, bottomRight, styleID)
+}
+
+// insertRowsFromQuery runs a.Query against ctx's *sql.DB and writes each
+// result row starting at a.Start, one row per record.
+func (r *renderer) insertRowsFromQuery(a Action) error {
+	db, err := r.db()
+	if err != nil {
+		return err
+	}
+
+	rows, err := db.Query(a.Query)
+	if err != nil {
+		return fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return fmt.Errorf("failed to read query columns: %w", err)
+	}
+
+	startCol, startRow, err := excelize.CellNameToCoordinates(a.Start)
+	if err != nil {
+		return fmt.Errorf("invalid start cell %q: %w", a.Start, err)
+	}
+
+	scanVals := make([]sql.RawBytes, len(cols))
+	scanDest := make([]interface{}, len(cols))
+	for i := range scanVals {
+		scanDest[i] = &scanVals[i]
+	}
+
+	row := startRow
+	for rows.Next() {
+		if err := rows.Scan(scanDest...); err != nil {
+			return fmt.Errorf("failed to scan query row: %w", err)
+		}
+		for i, v := range scanVals {
+			var cell interface{}
+			if v != nil {
+				cell = string(v)
+			}
+			if err := r.file.SetCellValue(a.Sheet, row, startCol+i, cell); err != nil {
+				return err
+			}
+		}
+		row++
+	}
+	return rows.Err()
+}
+
+func (r *renderer) db() (*sql.DB, error) {
+	if db, ok := r.ctx.(*sql.DB); ok {
+		return db, nil
+	}
+	if provider, ok := r.ctx.(interface{ DB() *sql.DB }); ok {
+		return provider.DB(), nil
+	}
+	return nil, fmt.Errorf("insert-rows-from-query requires ctx to be a *sql.DB or expose DB() *sql.DB")
+}
+
+// repeatBlock clones the template rows [StartRow, EndRow] of a.Sheet once
+// per item in a.Items, shifting the rows below down to make room, and fills
+// each copy with that item bound to {{.Item}} (and {{.Index}}).
+func (r *renderer) repeatBlock(a Action) error {
+	itemsVal, err := resolvePath(r.ctx, a.Items)
+	if err != nil {
+		return err
+	}
+	if itemsVal.Kind() != reflect.Slice {
+		return fmt.Errorf("items %q is not a slice", a.Items)
+	}
+
+	blockHeight := a.EndRow - a.StartRow + 1
+	if blockHeight <= 0 {
+		return fmt.Errorf("repeat-block: end_row must be >= start_row")
+	}
+
+	rows, err := r.file.ReadData(a.Sheet)
+	if err != nil {
+		return err
+	}
+	block := make([][]string, blockHeight)
+	for i := 0; i < blockHeight; i++ {
+		if rowIdx := a.StartRow - 1 + i; rowIdx < len(rows) {
+			block[i] = rows[rowIdx]
+		}
+	}
+
+	n := itemsVal.Len()
+	if n == 0 {
+		return nil
+	}
+
+	if extraRows := (n - 1) * blockHeight; extraRows > 0 {
+		if err := r.file.InsertRows(a.Sheet, a.EndRow+1, extraRows); err != nil {
+			return fmt.Errorf("failed to shift rows for repeat-block: %w", err)
+		}
+	}
+
+	for idx := 0; idx < n; idx++ {
+		itemCtx := map[string]interface{}{"Item": itemsVal.Index(idx).Interface(), "Index": idx}
+		for rOff, rowCells := range block {
+			targetRow := a.StartRow + idx*blockHeight + rOff
+			for cOff, raw := range rowCells {
+				if raw == "" {
+					continue
+				}
+				val, err := evalString(itemCtx, raw)
+				if err != nil {
+					return fmt.Errorf("row %d: %w", targetRow, err)
+				}
+				if err := r.file.SetCellValue(a.Sheet, targetRow, cOff+1, val); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func splitRange(rng string) (topLeft, bottomRight string, err error) {
+	parts := strings.SplitN(rng, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid range %q, expected TOPLEFT:BOTTOMRIGHT", rng)
+	}
+	return parts[0], parts[1], nil
+}
+
+// evalString renders a Go text/template expression (e.g. "{{.Title}}")
+// against ctx. Strings without "{{" are returned unchanged.
+func evalString(ctx interface{}, expr string) (string, error) {
+	if !strings.Contains(expr, "{{") {
+		return expr, nil
+	}
+	tmpl, err := template.New("cell").Parse(expr)
+	if err != nil {
+		return "", fmt.Errorf("invalid expression %q: %w", expr, err)
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, ctx); err != nil {
+		return "", fmt.Errorf("failed to evaluate %q: %w", expr, err)
+	}
+	return buf.String(), nil
+}
+
+// resolvePath walks a dotted field/map path (e.g. "Data.Items") over ctx.
+func resolvePath(ctx interface{}, path string) (reflect.Value, error) {
+	v := reflect.ValueOf(ctx)
+	for _, part := range strings.Split(strings.TrimPrefix(path, "."), ".") {
+		if part == "" {
+			continue
+		}
+		for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			v = v.Elem()
+		}
+		switch v.Kind() {
+		case reflect.Struct:
+			v = v.FieldByName(part)
+		case reflect.Map:
+			v = v.MapIndex(reflect.ValueOf(part))
+		default:
+			return reflect.Value{}, fmt.Errorf("cannot resolve %q on kind %s", part, v.Kind())
+		}
+		if !v.IsValid() {
+			return reflect.Value{}, fmt.Errorf("field %q not found", part)
+		}
+	}
+	for v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	return v, nil
+}
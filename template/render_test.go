@@ -0,0 +1,108 @@
+package template
+
+import (
+	"os"
+	"testing"
+
+	"github.com/tss182/excel"
+)
+
+func writeTemplateWorkbook(t *testing.T) string {
+	t.Helper()
+
+	f := excel.NewExcelFile()
+	defer f.Close()
+
+	if err := f.SetHeaders("Sheet1", []string{"Title", "Item"}); err != nil {
+		t.Fatalf("failed to write headers: %v", err)
+	}
+
+	path := t.TempDir() + "/template.xlsx"
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("failed to save template: %v", err)
+	}
+	return path
+}
+
+func writeDescriptor(t *testing.T, yamlContent string) string {
+	t.Helper()
+
+	path := t.TempDir() + "/descriptor.yaml"
+	if err := os.WriteFile(path, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("failed to write descriptor: %v", err)
+	}
+	return path
+}
+
+func TestRenderSetCellAndMerge(t *testing.T) {
+	templatePath := writeTemplateWorkbook(t)
+	descriptorPath := writeDescriptor(t, `
+actions:
+  - type: set-cell
+    sheet: Sheet1
+    cell: A2
+    value: "{{.Title}}"
+  - type: merge
+    sheet: Sheet1
+    range: "A1:B1"
+`)
+
+	ctx := struct{ Title string }{Title: "Monthly Report"}
+
+	out, err := Render(templatePath, descriptorPath, ctx)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	defer out.Close()
+
+	value, err := out.GetCellValue("Sheet1", 2, 1)
+	if err != nil {
+		t.Fatalf("failed to read rendered cell: %v", err)
+	}
+	if value != "Monthly Report" {
+		t.Errorf("expected %q, got %q", "Monthly Report", value)
+	}
+}
+
+func TestRenderRepeatBlock(t *testing.T) {
+	f := excel.NewExcelFile()
+	if err := f.SetHeaders("Sheet1", []string{"Title", "Item"}); err != nil {
+		t.Fatalf("failed to write headers: %v", err)
+	}
+	if err := f.SetRowValues("Sheet1", 2, []interface{}{"{{.Index}}", "{{.Item}}"}); err != nil {
+		t.Fatalf("failed to write block placeholder row: %v", err)
+	}
+	templatePath := t.TempDir() + "/template.xlsx"
+	if err := f.SaveAs(templatePath); err != nil {
+		t.Fatalf("failed to save template: %v", err)
+	}
+	f.Close()
+
+	descriptorPath := writeDescriptor(t, `
+actions:
+  - type: repeat-block
+    sheet: Sheet1
+    start_row: 2
+    end_row: 2
+    items: Items
+`)
+
+	ctx := struct{ Items []string }{Items: []string{"Widget", "Gadget"}}
+
+	out, err := Render(templatePath, descriptorPath, ctx)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	defer out.Close()
+
+	rows, err := out.ReadData("Sheet1")
+	if err != nil {
+		t.Fatalf("failed to read rendered sheet: %v", err)
+	}
+	if len(rows) < 3 {
+		t.Fatalf("expected at least 3 rows, got %d", len(rows))
+	}
+	if rows[1][1] != "Widget" || rows[2][1] != "Gadget" {
+		t.Fatalf("expected repeated items Widget/Gadget, got %v", rows)
+	}
+}
@@ -0,0 +1,57 @@
+package excel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// upperString implements ExcelUnmarshaler to uppercase whatever the cell
+// contains, so the test can tell the custom path ran instead of the
+// default string assignment.
+type upperString string
+
+func (u *upperString) UnmarshalExcelCell(raw, layout string) error {
+	*u = upperString(strings.ToUpper(raw))
+	return nil
+}
+
+type unmarshalerRow struct {
+	ID   int         `excel:"ID"`
+	Name upperString `excel:"Name"`
+}
+
+func TestReadUsesExcelUnmarshaler(t *testing.T) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetRow("Sheet1", "A1", &[]interface{}{"ID", "Name"}); err != nil {
+		t.Fatalf("failed to write header: %v", err)
+	}
+	if err := f.SetSheetRow("Sheet1", "A2", &[]interface{}{1, "alice"}); err != nil {
+		t.Fatalf("failed to write row: %v", err)
+	}
+
+	path := t.TempDir() + "/unmarshaler.xlsx"
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("failed to save workbook: %v", err)
+	}
+
+	e, err := Open[unmarshalerRow](path)
+	if err != nil {
+		t.Fatalf("failed to open workbook: %v", err)
+	}
+	defer e.Close()
+
+	var out []unmarshalerRow
+	if err := e.Read(&out, "Sheet1"); err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if len(out) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(out))
+	}
+	if out[0].Name != "ALICE" {
+		t.Errorf("expected custom unmarshaler to uppercase the value, got %q", out[0].Name)
+	}
+}
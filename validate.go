@@ -0,0 +1,139 @@
+package excel
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrorAction tells Read how to react to a row that failed validation or
+// conversion, as decided by Opt.OnRowError (or the CollectErrors default).
+type ErrorAction int
+
+const (
+	// ErrorAbort stops Read and returns the row's error immediately. This is
+	// the only action available when neither Opt.CollectErrors nor
+	// Opt.OnRowError is set, preserving Read's original fail-fast behavior.
+	ErrorAbort ErrorAction = iota
+	// ErrorSkip drops the row from the output slice and continues reading.
+	ErrorSkip
+	// ErrorKeepPartial keeps the row instead of dropping it, with every
+	// field that decoded successfully set, including fields after the
+	// failing one.
+	ErrorKeepPartial
+)
+
+// RowError describes one field-level failure (a missing required cell, a
+// failed conversion, or a failed validation tag) found while decoding a row.
+type RowError struct {
+	Row    uint
+	Col    string
+	Header string
+	Err    error
+}
+
+func (re RowError) Error() string {
+	return fmt.Sprintf("row %d col %s (%s): %v", re.Row, re.Col, re.Header, re.Err)
+}
+
+func (re RowError) Unwrap() error {
+	return re.Err
+}
+
+var emailRegex = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+
+// validateCell checks raw against rule's min/max/len/regex/oneof/email/
+// unique tag options. It runs before the cell is converted, so it always
+// sees the cell's trimmed text regardless of the destination field's type.
+func (e *Excel[T]) validateCell(rule *fieldRule, raw string) error {
+	if rule.regex != nil && !rule.regex.MatchString(raw) {
+		return fmt.Errorf("value %q does not match pattern %s", raw, rule.regex.String())
+	}
+	if rule.email && !emailRegex.MatchString(raw) {
+		return fmt.Errorf("value %q is not a valid email address", raw)
+	}
+	if len(rule.oneOf) > 0 {
+		ok := false
+		for _, v := range rule.oneOf {
+			if raw == v {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return fmt.Errorf("value %q is not one of %s", raw, strings.Join(rule.oneOf, "|"))
+		}
+	}
+	if rule.lenEq != nil && len(raw) != *rule.lenEq {
+		return fmt.Errorf("value %q must be %d characters, got %d", raw, *rule.lenEq, len(raw))
+	}
+	if rule.min != nil || rule.max != nil {
+		f, err := strconv.ParseFloat(cleanNumOptimized(raw), 64)
+		if err != nil {
+			return fmt.Errorf("value %q is not numeric: %w", raw, err)
+		}
+		if rule.min != nil && f < *rule.min {
+			return fmt.Errorf("value %v is below minimum %v", f, *rule.min)
+		}
+		if rule.max != nil && f > *rule.max {
+			return fmt.Errorf("value %v exceeds maximum %v", f, *rule.max)
+		}
+	}
+	if rule.unique && !e.checkUnique(rule.fieldIdx, raw) {
+		return fmt.Errorf("value %q is not unique", raw)
+	}
+	return nil
+}
+
+// checkUnique reports whether raw has not been seen before for fieldIdx
+// during this Read call, recording it if so. Safe for concurrent use by
+// parallel decode workers.
+func (e *Excel[T]) checkUnique(fieldIdx int, raw string) bool {
+	e.uniqueMu.Lock()
+	defer e.uniqueMu.Unlock()
+	if e.uniqueSeen == nil {
+		e.uniqueSeen = make(map[int]map[string]struct{})
+	}
+	seen, ok := e.uniqueSeen[fieldIdx]
+	if !ok {
+		seen = make(map[string]struct{})
+		e.uniqueSeen[fieldIdx] = seen
+	}
+	if _, dup := seen[raw]; dup {
+		return false
+	}
+	seen[raw] = struct{}{}
+	return true
+}
+
+// handleRowErrors records errs into e.Errors and asks Opt.OnRowError (if
+// set) what to do about each one, returning the most permissive action
+// applicable across the row (Abort wins outright; otherwise KeepPartial
+// wins over Skip). With neither Opt.CollectErrors nor Opt.OnRowError set,
+// it always returns ErrorAbort paired with the row's first error, matching
+// Read's original behavior.
+func (e *Excel[T]) handleRowErrors(errs []RowError) (ErrorAction, error) {
+	if !e.opt.CollectErrors && e.opt.OnRowError == nil {
+		return ErrorAbort, errs[0]
+	}
+
+	e.errMu.Lock()
+	e.Errors = append(e.Errors, errs...)
+	e.errMu.Unlock()
+
+	action := ErrorSkip
+	for _, re := range errs {
+		a := ErrorSkip
+		if e.opt.OnRowError != nil {
+			a = e.opt.OnRowError(re)
+		}
+		if a == ErrorAbort {
+			return ErrorAbort, re
+		}
+		if a == ErrorKeepPartial {
+			action = ErrorKeepPartial
+		}
+	}
+	return action, nil
+}
@@ -0,0 +1,73 @@
+package excel
+
+import (
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+type validateRow struct {
+	ID    int    `excel:"ID"`
+	Email string `excel:"Email,email"`
+	Score int    `excel:"Score,min=0,max=10"`
+}
+
+func validateWorkbook(t *testing.T) string {
+	t.Helper()
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetRow("Sheet1", "A1", &[]interface{}{"ID", "Email", "Score"}); err != nil {
+		t.Fatalf("failed to write header: %v", err)
+	}
+	if err := f.SetSheetRow("Sheet1", "A2", &[]interface{}{1, "alice@example.com", 8}); err != nil {
+		t.Fatalf("failed to write valid row: %v", err)
+	}
+	if err := f.SetSheetRow("Sheet1", "A3", &[]interface{}{2, "not-an-email", 99}); err != nil {
+		t.Fatalf("failed to write invalid row: %v", err)
+	}
+
+	path := t.TempDir() + "/validate.xlsx"
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("failed to save workbook: %v", err)
+	}
+	return path
+}
+
+func TestReadCollectsValidationErrors(t *testing.T) {
+	path := validateWorkbook(t)
+
+	e, err := Open[validateRow](path)
+	if err != nil {
+		t.Fatalf("failed to open workbook: %v", err)
+	}
+	defer e.Close()
+
+	var out []validateRow
+	err = e.Read(&out, "Sheet1", Opt{CollectErrors: true})
+	if err == nil {
+		t.Fatal("expected Read to report collected validation errors")
+	}
+	if len(e.Errors) == 0 {
+		t.Fatal("expected e.Errors to contain the row 3 validation failures")
+	}
+	if len(out) != 1 || out[0].ID != 1 {
+		t.Fatalf("expected only the valid row to survive, got %+v", out)
+	}
+}
+
+func TestReadAbortsOnValidationErrorByDefault(t *testing.T) {
+	path := validateWorkbook(t)
+
+	e, err := Open[validateRow](path)
+	if err != nil {
+		t.Fatalf("failed to open workbook: %v", err)
+	}
+	defer e.Close()
+
+	var out []validateRow
+	if err := e.Read(&out, "Sheet1"); err == nil {
+		t.Fatal("expected Read to fail fast on the invalid row without CollectErrors")
+	}
+}
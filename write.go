@@ -0,0 +1,146 @@
+package excel
+
+import (
+	"errors"
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"reflect"
+)
+
+// WriteOpt configures a Write or Marshal call.
+type WriteOpt struct {
+	HeaderRow uint8 // row the header line is written to, default 1
+}
+
+// RegisterStyle names an excelize.Style so a field's `excel` tag can apply
+// it by name via `style=name`, without every struct needing to know style
+// IDs (which are per-file and only exist once a style is created).
+func (e *Excel[T]) RegisterStyle(name string, s excelize.Style) {
+	if e.styles == nil {
+		e.styles = make(map[string]excelize.Style)
+	}
+	e.styles[name] = s
+}
+
+// resolveStyle creates (or reuses) the excelize style id for a write rule's
+// `numfmt=`/`style=` tag options, or returns 0 if the column has neither.
+func (e *Excel[T]) resolveStyle(r writeRule) (int, error) {
+	if r.styleName == "" && r.numFmt == 0 {
+		return 0, nil
+	}
+
+	var style excelize.Style
+	if r.styleName != "" {
+		s, ok := e.styles[r.styleName]
+		if !ok {
+			return 0, fmt.Errorf("unregistered style %q on header %q", r.styleName, r.header)
+		}
+		style = s
+	}
+	if r.numFmt != 0 {
+		style.NumFmt = r.numFmt
+	}
+
+	id, err := e.file.NewStyle(&style)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create style for header %q: %w", r.header, err)
+	}
+	return id, nil
+}
+
+// Write lays out in as headers plus rows on sheetName using the same
+// `excel:"..."` struct tags Read uses, additionally honoring `numfmt=` and
+// `style=` for cell formatting. It is the write-direction counterpart to
+// Read; for constant-memory export of very large slices, use WriteStream.
+func (e *Excel[T]) Write(in []T, sheetName string, opts ...WriteOpt) error {
+	var headerRow uint8 = 1
+	for _, opt := range opts {
+		if opt.HeaderRow > 0 {
+			headerRow = opt.HeaderRow
+		}
+	}
+
+	if e.file == nil {
+		return errors.New("file didn't set")
+	}
+
+	if e.rt == nil {
+		var zero T
+		rt := reflect.TypeOf(zero)
+		if rt.Kind() == reflect.Pointer {
+			rt = rt.Elem()
+		}
+		e.rt = rt
+	}
+
+	rules, err := buildWriteRules(e.rt)
+	if err != nil {
+		return err
+	}
+
+	if _, err := e.file.GetSheetIndex(sheetName); err != nil {
+		if _, err := e.file.NewSheet(sheetName); err != nil {
+			return fmt.Errorf("failed to create sheet %s: %w", sheetName, err)
+		}
+	}
+
+	styleIDs := make([]int, len(rules))
+	for i, r := range rules {
+		id, err := e.resolveStyle(r)
+		if err != nil {
+			return err
+		}
+		styleIDs[i] = id
+	}
+
+	for i, r := range rules {
+		cell, err := excelize.CoordinatesToCellName(i+1, int(headerRow))
+		if err != nil {
+			return fmt.Errorf("failed to resolve header cell for %q: %w", r.header, err)
+		}
+		if err := e.file.SetCellValue(sheetName, cell, r.header); err != nil {
+			return fmt.Errorf("failed to write header %q: %w", r.header, err)
+		}
+	}
+
+	for rowOffset, item := range in {
+		rv := reflect.ValueOf(item)
+		if rv.Kind() == reflect.Pointer {
+			rv = rv.Elem()
+		}
+		row := int(headerRow) + 1 + rowOffset
+		for colIdx, rule := range rules {
+			cell, err := excelize.CoordinatesToCellName(colIdx+1, row)
+			if err != nil {
+				return fmt.Errorf("failed to resolve cell at row %d: %w", row, err)
+			}
+			if err := e.file.SetCellValue(sheetName, cell, rv.Field(rule.fieldIdx).Interface()); err != nil {
+				return fmt.Errorf("failed to write row %d: %w", row, err)
+			}
+			if styleIDs[colIdx] != 0 {
+				if err := e.file.SetCellStyle(sheetName, cell, cell, styleIDs[colIdx]); err != nil {
+					return fmt.Errorf("failed to style cell %s: %w", cell, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Marshal writes in to a new workbook's "Sheet1" and returns the XLSX bytes,
+// the same way json.Marshal turns a value into bytes.
+func Marshal[T any](in []T, opts ...WriteOpt) ([]byte, error) {
+	e := New[T]()
+	defer e.Close()
+
+	if err := e.Write(in, "Sheet1", opts...); err != nil {
+		return nil, err
+	}
+
+	buf, err := e.file.WriteToBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal workbook: %w", err)
+	}
+	return buf.Bytes(), nil
+}
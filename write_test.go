@@ -0,0 +1,79 @@
+package excel
+
+import (
+	"os"
+	"testing"
+)
+
+type writeRow struct {
+	ID    int    `excel:"ID"`
+	Name  string `excel:"Name"`
+	Email string `excel:"Email"`
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	in := []writeRow{
+		{ID: 1, Name: "Alice", Email: "alice@example.com"},
+		{ID: 2, Name: "Bob", Email: "bob@example.com"},
+	}
+
+	e := New[writeRow]()
+	defer e.Close()
+
+	if err := e.Write(in, "Sheet1"); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	path := t.TempDir() + "/write.xlsx"
+	if err := e.file.SaveAs(path); err != nil {
+		t.Fatalf("failed to save workbook: %v", err)
+	}
+
+	r, err := Open[writeRow](path)
+	if err != nil {
+		t.Fatalf("failed to reopen workbook: %v", err)
+	}
+	defer r.Close()
+
+	var out []writeRow
+	if err := r.Read(&out, "Sheet1"); err != nil {
+		t.Fatalf("failed to read back rows: %v", err)
+	}
+	if len(out) != len(in) {
+		t.Fatalf("expected %d rows, got %d", len(in), len(out))
+	}
+	if out[1].Name != "Bob" || out[1].Email != "bob@example.com" {
+		t.Errorf("unexpected second row: %+v", out[1])
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	in := []writeRow{{ID: 1, Name: "Alice", Email: "alice@example.com"}}
+
+	data, err := Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if len(data) == 0 {
+		t.Fatal("Marshal returned no bytes")
+	}
+
+	path := t.TempDir() + "/marshal.xlsx"
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("failed to write marshaled bytes: %v", err)
+	}
+
+	e, err := Open[writeRow](path)
+	if err != nil {
+		t.Fatalf("failed to reopen marshaled workbook: %v", err)
+	}
+	defer e.Close()
+
+	var out []writeRow
+	if err := e.Read(&out, "Sheet1"); err != nil {
+		t.Fatalf("failed to read back marshaled rows: %v", err)
+	}
+	if len(out) != 1 || out[0].Name != "Alice" {
+		t.Fatalf("unexpected marshaled rows: %+v", out)
+	}
+}